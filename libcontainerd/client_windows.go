@@ -134,7 +134,7 @@ func (clnt *client) Create(containerID string, spec Spec, options ...CreateOptio
 		}
 		if spec.Windows.Resources.Memory != nil {
 			if spec.Windows.Resources.Memory.Limit != nil {
-				cu.MemoryMaximumInMB = *spec.Windows.Resources.Memory.Limit / 1024 / 1024
+				cu.MemoryMaximumInMB = memoryLimitInMB(*spec.Windows.Resources.Memory.Limit)
 			}
 		}
 		if spec.Windows.Resources.Storage != nil {
@@ -14,3 +14,11 @@ func setupEnvironmentVariables(a []string) map[string]string {
 	}
 	return r
 }
+
+// memoryLimitInMB converts a memory limit in bytes to megabytes as required
+// by the HCS, rounding up so the container is never given less memory than
+// it requested.
+func memoryLimitInMB(limit int64) int64 {
+	const mb = 1024 * 1024
+	return (limit + mb - 1) / mb
+}
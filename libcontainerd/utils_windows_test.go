@@ -0,0 +1,22 @@
+package libcontainerd
+
+import "testing"
+
+func TestMemoryLimitInMB(t *testing.T) {
+	cases := []struct {
+		limit    int64
+		expected int64
+	}{
+		{0, 0},
+		{1, 1},
+		{1024 * 1024, 1},
+		{1024*1024 + 1, 2},
+		{512*1024*1024 - 1, 512},
+		{512 * 1024 * 1024, 512},
+	}
+	for _, c := range cases {
+		if actual := memoryLimitInMB(c.limit); actual != c.expected {
+			t.Fatalf("memoryLimitInMB(%d): expected %d, got %d", c.limit, c.expected, actual)
+		}
+	}
+}